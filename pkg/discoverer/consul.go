@@ -0,0 +1,136 @@
+package discoverer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	consul "github.com/hashicorp/consul/api"
+)
+
+// ConsulOption defines configuration options for a Consul Discoverer.
+type ConsulOption func(*Consul)
+
+// WithConsulTag restricts service lookups to instances registered with the given tag.
+func WithConsulTag(tag string) ConsulOption {
+	return func(c *Consul) {
+		c.tag = tag
+	}
+}
+
+// WithConsulStrategy sets the load-balancing Strategy used to pick between healthy instances. Defaults to
+// RoundRobin.
+func WithConsulStrategy(s Strategy) ConsulOption {
+	return func(c *Consul) {
+		c.strategy = s
+	}
+}
+
+// WithConsulTTL sets how often the Consul Discoverer refreshes its instance list in the background. Defaults to
+// 10 seconds.
+func WithConsulTTL(ttl time.Duration) ConsulOption {
+	return func(c *Consul) {
+		c.ttl = ttl
+	}
+}
+
+// Consul implements the Discoverer interface watching a service (optionally filtered by tag) in a Consul catalog
+// and returning the URL of a healthy instance, selected by the configured Strategy.
+type Consul struct {
+	client  *consul.Client
+	service string
+	tag     string
+
+	strategy Strategy
+	ttl      time.Duration
+
+	mu        sync.RWMutex
+	instances []Instance
+
+	stop chan struct{}
+}
+
+// NewConsul initializes a new Consul Discoverer for the given service name using the given *consul.Client,
+// performs an initial synchronous fetch of healthy instances, and starts a background refresh loop. Callers must
+// call Close when the Discoverer is no longer needed to stop the refresh loop.
+func NewConsul(client *consul.Client, service string, options ...ConsulOption) (*Consul, error) {
+	c := &Consul{
+		client:   client,
+		service:  service,
+		strategy: NewRoundRobin(),
+		ttl:      10 * time.Second,
+		stop:     make(chan struct{}),
+	}
+	for _, option := range options {
+		option(c)
+	}
+
+	if err := c.refresh(); err != nil {
+		return nil, fmt.Errorf("error performing initial consul refresh: %w", err)
+	}
+
+	go c.refreshLoop()
+
+	return c, nil
+}
+
+// URL implements the Discoverer.URL method returning the URL of a healthy instance chosen by the configured
+// Strategy.
+func (c *Consul) URL() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.instances) == 0 {
+		return ""
+	}
+
+	return c.strategy.Pick(c.instances).URL
+}
+
+// Close stops the background refresh loop.
+func (c *Consul) Close() {
+	close(c.stop)
+}
+
+func (c *Consul) refreshLoop() {
+	ticker := time.NewTicker(c.ttl)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = c.refresh() // best effort, keep serving the last known good instances on error
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *Consul) refresh() error {
+	entries, _, err := c.client.Health().Service(c.service, c.tag, true, nil)
+	if err != nil {
+		return fmt.Errorf("error fetching consul service health: %w", err)
+	}
+
+	instances := make([]Instance, 0, len(entries))
+	for _, e := range entries {
+		addr := e.Service.Address
+		if addr == "" {
+			addr = e.Node.Address
+		}
+		instances = append(instances, Instance{
+			URL:    fmt.Sprintf("http://%s:%d", addr, e.Service.Port),
+			Weight: e.Service.Weights.Passing,
+		})
+	}
+
+	if len(instances) == 0 {
+		return fmt.Errorf("no healthy instances found for service %q", c.service)
+	}
+
+	c.mu.Lock()
+	c.instances = instances
+	c.mu.Unlock()
+
+	return nil
+}
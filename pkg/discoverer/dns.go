@@ -0,0 +1,146 @@
+package discoverer
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DNSOption defines configuration options for a DNS Discoverer.
+type DNSOption func(*DNS)
+
+// WithDNSStrategy sets the load-balancing Strategy used to pick between resolved instances, applied after
+// SRV priority selection. Defaults to Weighted, honoring SRV record weights.
+func WithDNSStrategy(s Strategy) DNSOption {
+	return func(d *DNS) {
+		d.strategy = s
+	}
+}
+
+// WithDNSTTL sets how often the DNS Discoverer re-resolves the SRV record in the background. Defaults to
+// 30 seconds.
+func WithDNSTTL(ttl time.Duration) DNSOption {
+	return func(d *DNS) {
+		d.ttl = ttl
+	}
+}
+
+// WithDNSScheme sets the URL scheme used when building instance URLs from resolved SRV targets. Defaults to
+// "http".
+func WithDNSScheme(scheme string) DNSOption {
+	return func(d *DNS) {
+		d.scheme = scheme
+	}
+}
+
+// DNS implements the Discoverer interface resolving a "_service._proto.name" SRV record and returning the URL of
+// an instance from the highest-priority group, selected by the configured Strategy (which defaults to honoring
+// SRV weights).
+type DNS struct {
+	service string
+	proto   string
+	name    string
+	scheme  string
+
+	strategy Strategy
+	ttl      time.Duration
+
+	mu        sync.RWMutex
+	instances []Instance
+
+	stop chan struct{}
+}
+
+// NewDNS initializes a new DNS Discoverer resolving "_service._proto.name", performs an initial synchronous
+// lookup, and starts a background refresh loop. Callers must call Close when the Discoverer is no longer needed
+// to stop the refresh loop.
+func NewDNS(service, proto, name string, options ...DNSOption) (*DNS, error) {
+	d := &DNS{
+		service:  service,
+		proto:    proto,
+		name:     name,
+		scheme:   "http",
+		strategy: NewWeighted(),
+		ttl:      30 * time.Second,
+		stop:     make(chan struct{}),
+	}
+	for _, option := range options {
+		option(d)
+	}
+
+	if err := d.refresh(); err != nil {
+		return nil, fmt.Errorf("error performing initial dns refresh: %w", err)
+	}
+
+	go d.refreshLoop()
+
+	return d, nil
+}
+
+// URL implements the Discoverer.URL method returning the URL of an instance chosen by the configured Strategy.
+func (d *DNS) URL() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if len(d.instances) == 0 {
+		return ""
+	}
+
+	return d.strategy.Pick(d.instances).URL
+}
+
+// Close stops the background refresh loop.
+func (d *DNS) Close() {
+	close(d.stop)
+}
+
+func (d *DNS) refreshLoop() {
+	ticker := time.NewTicker(d.ttl)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = d.refresh() // best effort, keep serving the last known good instances on error
+		case <-d.stop:
+			return
+		}
+	}
+}
+
+func (d *DNS) refresh() error {
+	_, srvs, err := net.LookupSRV(d.service, d.proto, d.name)
+	if err != nil {
+		return fmt.Errorf("error resolving srv record: %w", err)
+	}
+	if len(srvs) == 0 {
+		return fmt.Errorf("no srv records found for %q", d.name)
+	}
+
+	// only consider the lowest (highest-priority) priority group, per SRV semantics.
+	minPriority := srvs[0].Priority
+	for _, s := range srvs {
+		if s.Priority < minPriority {
+			minPriority = s.Priority
+		}
+	}
+
+	instances := make([]Instance, 0, len(srvs))
+	for _, s := range srvs {
+		if s.Priority != minPriority {
+			continue
+		}
+		instances = append(instances, Instance{
+			URL:    fmt.Sprintf("%s://%s:%d", d.scheme, strings.TrimSuffix(s.Target, "."), s.Port),
+			Weight: int(s.Weight),
+		})
+	}
+
+	d.mu.Lock()
+	d.instances = instances
+	d.mu.Unlock()
+
+	return nil
+}
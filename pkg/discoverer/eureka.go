@@ -0,0 +1,202 @@
+package discoverer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EurekaOption defines configuration options for a Eureka Discoverer.
+type EurekaOption func(*Eureka)
+
+// WithEurekaStrategy sets the load-balancing Strategy used to pick between UP instances. Defaults to RoundRobin.
+func WithEurekaStrategy(s Strategy) EurekaOption {
+	return func(e *Eureka) {
+		e.strategy = s
+	}
+}
+
+// WithEurekaTTL sets how often the Eureka Discoverer re-fetches the app's instance list in the background.
+// Defaults to 30 seconds.
+func WithEurekaTTL(ttl time.Duration) EurekaOption {
+	return func(e *Eureka) {
+		e.ttl = ttl
+	}
+}
+
+// WithEurekaHTTPClient sets the *http.Client used to query the Eureka registry. Defaults to http.DefaultClient.
+func WithEurekaHTTPClient(hc *http.Client) EurekaOption {
+	return func(e *Eureka) {
+		e.client = hc
+	}
+}
+
+// eurekaApp is the subset of a single <application> entry in the Eureka "/apps" response body needed to select
+// instances.
+type eurekaApp struct {
+	Name     string `json:"name"`
+	Instance []struct {
+		HostName string `json:"hostName"`
+		Port     struct {
+			Value   int    `json:"$"`
+			Enabled string `json:"@enabled"`
+		} `json:"port"`
+		SecurePort struct {
+			Value   int    `json:"$"`
+			Enabled string `json:"@enabled"`
+		} `json:"securePort"`
+		Status string `json:"status"`
+	} `json:"instance"`
+}
+
+// eurekaAppsResponse mirrors the subset of the Eureka "/apps" registry response body (the full application list,
+// as polled by Prometheus's Eureka service discovery) needed to select instances for a single app.
+type eurekaAppsResponse struct {
+	Applications struct {
+		Application []eurekaApp `json:"application"`
+	} `json:"applications"`
+}
+
+// Eureka implements the Discoverer interface polling a Netflix-Eureka-style registry's full application list
+// ("{registryURL}/apps") and returning the URL of an UP instance of the configured app, selected by the
+// configured Strategy. Eureka instance metadata has no native weight attribute, so Weight is always left at its
+// zero value (treated as 1 by Weighted, i.e. uniform selection) — weighted load balancing is effectively
+// unsupported for this Discoverer.
+type Eureka struct {
+	registryURL string
+	appName     string
+	client      *http.Client
+
+	strategy Strategy
+	ttl      time.Duration
+
+	mu        sync.RWMutex
+	instances []Instance
+
+	stop chan struct{}
+}
+
+// NewEureka initializes a new Eureka Discoverer for the given app name against the given registry base URL,
+// performs an initial synchronous fetch of UP instances, and starts a background refresh loop. Callers must call
+// Close when the Discoverer is no longer needed to stop the refresh loop.
+func NewEureka(registryURL, appName string, options ...EurekaOption) (*Eureka, error) {
+	e := &Eureka{
+		registryURL: strings.TrimRight(registryURL, "/"),
+		appName:     appName,
+		client:      http.DefaultClient,
+		strategy:    NewRoundRobin(),
+		ttl:         30 * time.Second,
+		stop:        make(chan struct{}),
+	}
+	for _, option := range options {
+		option(e)
+	}
+
+	if err := e.refresh(); err != nil {
+		return nil, fmt.Errorf("error performing initial eureka refresh: %w", err)
+	}
+
+	go e.refreshLoop()
+
+	return e, nil
+}
+
+// URL implements the Discoverer.URL method returning the URL of an UP instance chosen by the configured Strategy.
+func (e *Eureka) URL() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if len(e.instances) == 0 {
+		return ""
+	}
+
+	return e.strategy.Pick(e.instances).URL
+}
+
+// Close stops the background refresh loop.
+func (e *Eureka) Close() {
+	close(e.stop)
+}
+
+func (e *Eureka) refreshLoop() {
+	ticker := time.NewTicker(e.ttl)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = e.refresh() // best effort, keep serving the last known good instances on error
+		case <-e.stop:
+			return
+		}
+	}
+}
+
+func (e *Eureka) refresh() error {
+	url := fmt.Sprintf("%s/apps", e.registryURL)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating eureka request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error fetching eureka app list: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("eureka registry returned status %d", resp.StatusCode)
+	}
+
+	var body eurekaAppsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("error decoding eureka app list: %w", err)
+	}
+
+	app, ok := findEurekaApp(body.Applications.Application, e.appName)
+	if !ok {
+		return fmt.Errorf("app %q not found in eureka app list", e.appName)
+	}
+
+	instances := make([]Instance, 0, len(app.Instance))
+	for _, i := range app.Instance {
+		if i.Status != "UP" {
+			continue
+		}
+
+		port := i.Port.Value
+		scheme := "http"
+		if i.SecurePort.Enabled == "true" {
+			port = i.SecurePort.Value
+			scheme = "https"
+		}
+
+		instances = append(instances, Instance{URL: fmt.Sprintf("%s://%s:%d", scheme, i.HostName, port)})
+	}
+
+	if len(instances) == 0 {
+		return fmt.Errorf("no UP instances found for app %q", e.appName)
+	}
+
+	e.mu.Lock()
+	e.instances = instances
+	e.mu.Unlock()
+
+	return nil
+}
+
+// findEurekaApp returns the application in apps whose name matches appName (Eureka app names are conventionally
+// uppercase, so the match is case-insensitive), or ok=false if there isn't one.
+func findEurekaApp(apps []eurekaApp, appName string) (eurekaApp, bool) {
+	for _, app := range apps {
+		if strings.EqualFold(app.Name, appName) {
+			return app, true
+		}
+	}
+	return eurekaApp{}, false
+}
@@ -0,0 +1,80 @@
+package discoverer
+
+import (
+	"math/rand"
+	"sync/atomic"
+)
+
+// Instance represents a single resolved service endpoint that a dynamic Discoverer can choose between.
+type Instance struct {
+	URL    string
+	Weight int
+}
+
+// Strategy defines an interface for picking a single Instance out of a set of candidates.
+type Strategy interface {
+	Pick(instances []Instance) Instance
+}
+
+// RoundRobin implements Strategy cycling through the given instances in order.
+type RoundRobin struct {
+	next uint32
+}
+
+// NewRoundRobin initializes a new RoundRobin Strategy.
+func NewRoundRobin() *RoundRobin {
+	return &RoundRobin{}
+}
+
+// Pick implements the Strategy.Pick method returning the next instance in sequence.
+func (r *RoundRobin) Pick(instances []Instance) Instance {
+	n := atomic.AddUint32(&r.next, 1)
+	return instances[(int(n)-1)%len(instances)]
+}
+
+// Random implements Strategy picking a uniformly random instance.
+type Random struct{}
+
+// NewRandom initializes a new Random Strategy.
+func NewRandom() *Random {
+	return &Random{}
+}
+
+// Pick implements the Strategy.Pick method returning a random instance.
+func (r *Random) Pick(instances []Instance) Instance {
+	return instances[rand.Intn(len(instances))] //nolint:gosec
+}
+
+// Weighted implements Strategy picking an instance with probability proportional to its Weight. Instances with a
+// Weight <= 0 are treated as Weight 1.
+type Weighted struct{}
+
+// NewWeighted initializes a new Weighted Strategy.
+func NewWeighted() *Weighted {
+	return &Weighted{}
+}
+
+// Pick implements the Strategy.Pick method returning a weighted-random instance.
+func (w *Weighted) Pick(instances []Instance) Instance {
+	var total int
+	for _, i := range instances {
+		total += weight(i)
+	}
+
+	n := rand.Intn(total) //nolint:gosec
+	for _, i := range instances {
+		n -= weight(i)
+		if n < 0 {
+			return i
+		}
+	}
+
+	return instances[len(instances)-1]
+}
+
+func weight(i Instance) int {
+	if i.Weight <= 0 {
+		return 1
+	}
+	return i.Weight
+}
@@ -0,0 +1,35 @@
+package discoverer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/discoverer"
+)
+
+func TestRoundRobinPick(t *testing.T) {
+	t.Parallel()
+	instances := []discoverer.Instance{{URL: "a"}, {URL: "b"}, {URL: "c"}}
+	s := discoverer.NewRoundRobin()
+	require.NotNil(t, s)
+
+	got := []string{s.Pick(instances).URL, s.Pick(instances).URL, s.Pick(instances).URL, s.Pick(instances).URL}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, got)
+}
+
+func TestRandomPick(t *testing.T) {
+	t.Parallel()
+	instances := []discoverer.Instance{{URL: "a"}, {URL: "b"}}
+	s := discoverer.NewRandom()
+	require.NotNil(t, s)
+	assert.Contains(t, []string{"a", "b"}, s.Pick(instances).URL)
+}
+
+func TestWeightedPick(t *testing.T) {
+	t.Parallel()
+	instances := []discoverer.Instance{{URL: "a", Weight: 1}, {URL: "b", Weight: 0}}
+	s := discoverer.NewWeighted()
+	require.NotNil(t, s)
+	assert.Contains(t, []string{"a", "b"}, s.Pick(instances).URL)
+}
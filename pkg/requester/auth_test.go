@@ -0,0 +1,113 @@
+package requester_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/discoverer"
+	"github.com/syndio/apir/pkg/requester"
+)
+
+func TestBearerTokenApply(t *testing.T) {
+	t.Parallel()
+	req, err := http.NewRequest(http.MethodGet, "http://foo.bar", nil)
+	require.NoError(t, err)
+
+	auth := requester.NewBearerToken("secret")
+	require.NoError(t, auth.Apply(req))
+	assert.Equal(t, "Bearer secret", req.Header.Get("Authorization"))
+}
+
+func TestBasicAuthApply(t *testing.T) {
+	t.Parallel()
+	req, err := http.NewRequest(http.MethodGet, "http://foo.bar", nil)
+	require.NoError(t, err)
+
+	auth := requester.NewBasicAuth("user", "pass")
+	require.NoError(t, auth.Apply(req))
+	user, pass, ok := req.BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "pass", pass)
+}
+
+func TestAPIKeyApply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("header", func(t *testing.T) {
+		t.Parallel()
+		req, err := http.NewRequest(http.MethodGet, "http://foo.bar", nil)
+		require.NoError(t, err)
+
+		auth := requester.NewAPIKey("X-Api-Key", "secret", requester.APIKeyHeader)
+		require.NoError(t, auth.Apply(req))
+		assert.Equal(t, "secret", req.Header.Get("X-Api-Key"))
+	})
+
+	t.Run("query", func(t *testing.T) {
+		t.Parallel()
+		req, err := http.NewRequest(http.MethodGet, "http://foo.bar", nil)
+		require.NoError(t, err)
+
+		auth := requester.NewAPIKey("api_key", "secret", requester.APIKeyQuery)
+		require.NoError(t, auth.Apply(req))
+		assert.Equal(t, "secret", req.URL.Query().Get("api_key"))
+	})
+}
+
+func TestClientExecute_AuthenticatorRefreshOn401(t *testing.T) {
+	t.Parallel()
+
+	var refreshed bool
+	var calls int
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"color":"red"}`))
+	}))
+	defer authServer.Close()
+
+	auth := &testAuthenticator{token: "stale", onRefresh: func() { refreshed = true }}
+
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("auth", discoverer.NewDirect(authServer.URL),
+		requester.WithAuthenticator(auth)))
+
+	req, err := client.NewRequest(context.TODO(), "auth", http.MethodGet, "/", nil)
+	require.NoError(t, err)
+
+	var data struct {
+		Color string `json:"color"`
+	}
+	ok, err := client.Execute(req, &data, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, refreshed)
+	assert.Equal(t, 2, calls, "expected one failed attempt and one retry after refresh")
+	assert.Equal(t, "red", data.Color)
+}
+
+// testAuthenticator implements requester.Authenticator and requester.Refresher for TestClientExecute_AuthenticatorRefreshOn401.
+type testAuthenticator struct {
+	token     string
+	onRefresh func()
+}
+
+func (a *testAuthenticator) Apply(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+func (a *testAuthenticator) Refresh(_ context.Context) error {
+	a.token = "fresh"
+	a.onRefresh()
+	return nil
+}
@@ -0,0 +1,229 @@
+package requester
+
+import (
+	"container/list"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a stored copy of a prior response, enough to either serve it directly or revalidate it with
+// the origin via conditional request headers.
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	StoredAt   time.Time
+	TTL        time.Duration
+}
+
+// Fresh reports whether cr is still within its TTL and can be served without revalidation.
+func (cr *CachedResponse) Fresh() bool {
+	return cr.TTL > 0 && time.Since(cr.StoredAt) < cr.TTL
+}
+
+// Cache defines an interface for storing CachedResponses, keyed by a cache key Execute derives from the request's
+// method and URL (see cacheKey; request headers are not part of the key). Backends beyond the built-in LRUCache/FileCache (e.g. Redis) can be
+// plugged in by implementing this interface directly.
+type Cache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, cr *CachedResponse, ttl time.Duration)
+}
+
+// WithCache sets the Cache used to store idempotent (GET/HEAD) responses. Replaces any existing Cache. Caching is
+// opt-in: a Client with no Cache configured never reads or writes a cache.
+func WithCache(c Cache) ClientOption {
+	return func(c2 *Client) {
+		c2.cache = c
+	}
+}
+
+// WithCacheTTL sets how long a fresh cache entry is served for an API before it must be revalidated with the
+// origin. Defaults to 0 (always revalidate) if unset.
+func WithCacheTTL(ttl time.Duration) APIOption {
+	return func(api *API) {
+		api.cacheTTL = ttl
+	}
+}
+
+// cacheKey returns the Cache key for a request, based on method and URL. Responses that vary by header (e.g.
+// Authorization, Accept-Language) are out of scope for the built-in key derivation; callers needing that can
+// implement Cache themselves with a key scheme of their choosing.
+func cacheKey(method, url string) string {
+	return method + " " + url
+}
+
+// isCacheable reports whether method is one Execute will attempt to cache (idempotent, safe methods only).
+func isCacheable(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// lruEntry is the value stored in LRUCache's internal list.
+type lruEntry struct {
+	key string
+	cr  *CachedResponse
+}
+
+// LRUCache implements Cache as an in-memory, size-bounded, least-recently-used cache.
+type LRUCache struct {
+	capacity int
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	order *list.List
+}
+
+// NewLRUCache initializes a new in-memory LRUCache holding at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = 100
+	}
+	return &LRUCache{capacity: capacity, items: make(map[string]*list.Element), order: list.New()}
+}
+
+// Get implements the Cache.Get method.
+func (l *LRUCache) Get(key string) (*CachedResponse, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(el)
+	return el.Value.(*lruEntry).cr, true //nolint:forcetypeassert
+}
+
+// Set implements the Cache.Set method.
+func (l *LRUCache) Set(key string, cr *CachedResponse, ttl time.Duration) {
+	cr.TTL = ttl
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		el.Value.(*lruEntry).cr = cr //nolint:forcetypeassert
+		l.order.MoveToFront(el)
+		return
+	}
+
+	el := l.order.PushFront(&lruEntry{key: key, cr: cr})
+	l.items[key] = el
+
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		if oldest != nil {
+			l.order.Remove(oldest)
+			delete(l.items, oldest.Value.(*lruEntry).key) //nolint:forcetypeassert
+		}
+	}
+}
+
+// FileCache implements Cache persisting each entry as a file under Dir, keyed by a filesystem-safe hash of the
+// cache key. It has no in-memory size bound; callers wanting eviction should prune Dir out of band.
+type FileCache struct {
+	Dir string
+}
+
+// NewFileCache initializes a new FileCache storing entries under dir, creating it if necessary.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating cache dir: %w", err)
+	}
+	return &FileCache{Dir: dir}, nil
+}
+
+func (f *FileCache) path(key string) string {
+	return filepath.Join(f.Dir, sha256Hex([]byte(key)))
+}
+
+// Get implements the Cache.Get method.
+func (f *FileCache) Get(key string) (*CachedResponse, bool) {
+	b, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return decodeFileCacheEntry(b)
+}
+
+// Set implements the Cache.Set method.
+func (f *FileCache) Set(key string, cr *CachedResponse, ttl time.Duration) {
+	cr.TTL = ttl
+	b := encodeFileCacheEntry(cr)
+	_ = os.WriteFile(f.path(key), b, 0o600) //nolint:errcheck
+}
+
+// encodeFileCacheEntry/decodeFileCacheEntry use a minimal line-oriented format (status, stored-at unix nanos, ttl
+// nanos, header count, headers, then the raw body) so FileCache avoids pulling in an encoding dependency.
+func encodeFileCacheEntry(cr *CachedResponse) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d\n%d\n%d\n", cr.StatusCode, cr.StoredAt.UnixNano(), cr.TTL)
+	fmt.Fprintf(&b, "%d\n", len(cr.Header))
+	for k, vs := range cr.Header {
+		fmt.Fprintf(&b, "%s\t%s\n", k, strings.Join(vs, "\t"))
+	}
+	b.Write(cr.Body)
+	return []byte(b.String())
+}
+
+func decodeFileCacheEntry(b []byte) (*CachedResponse, bool) {
+	s := string(b)
+	lines := strings.SplitN(s, "\n", 4)
+	if len(lines) < 4 {
+		return nil, false
+	}
+
+	status, err := strconv.Atoi(lines[0])
+	if err != nil {
+		return nil, false
+	}
+	storedAtNano, err := strconv.ParseInt(lines[1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	ttlNano, err := strconv.ParseInt(lines[2], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	headerCount, rest, ok := cutFirstLine(lines[3])
+	if !ok {
+		return nil, false
+	}
+	n, err := strconv.Atoi(headerCount)
+	if err != nil {
+		return nil, false
+	}
+
+	header := http.Header{}
+	for i := 0; i < n; i++ {
+		var line string
+		line, rest, ok = cutFirstLine(rest)
+		if !ok {
+			return nil, false
+		}
+		k, vs, found := strings.Cut(line, "\t")
+		if !found {
+			continue
+		}
+		header[k] = strings.Split(vs, "\t")
+	}
+
+	return &CachedResponse{
+		StatusCode: status,
+		Header:     header,
+		Body:       []byte(rest),
+		StoredAt:   time.Unix(0, storedAtNano),
+		TTL:        time.Duration(ttlNano),
+	}, true
+}
+
+func cutFirstLine(s string) (line, rest string, ok bool) {
+	line, rest, found := strings.Cut(s, "\n")
+	return line, rest, found
+}
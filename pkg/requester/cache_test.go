@@ -0,0 +1,96 @@
+package requester_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/discoverer"
+	"github.com/syndio/apir/pkg/requester"
+)
+
+func TestLRUCacheGetSet(t *testing.T) {
+	t.Parallel()
+	c := requester.NewLRUCache(1)
+
+	c.Set("a", &requester.CachedResponse{StatusCode: 200, Body: []byte("1")}, time.Minute)
+	c.Set("b", &requester.CachedResponse{StatusCode: 200, Body: []byte("2")}, time.Minute)
+
+	_, ok := c.Get("a")
+	assert.False(t, ok, "a should have been evicted once capacity was exceeded")
+
+	cr, ok := c.Get("b")
+	require.True(t, ok)
+	assert.Equal(t, "2", string(cr.Body))
+}
+
+func TestClientExecute_CacheFreshHit(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	cacheServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"color":"red"}`))
+	}))
+	defer cacheServer.Close()
+
+	client := requester.NewClient("test", requester.WithCache(requester.NewLRUCache(10)))
+	require.NoError(t, client.AddAPI("cache", discoverer.NewDirect(cacheServer.URL),
+		requester.WithCacheTTL(time.Minute)))
+
+	for i := 0; i < 2; i++ {
+		req, err := client.NewRequest(context.TODO(), "cache", http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		var data struct {
+			Color string `json:"color"`
+		}
+		ok, err := client.Execute(req, &data, nil)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "red", data.Color)
+	}
+
+	assert.Equal(t, 1, calls, "second request should have been served from cache")
+}
+
+func TestClientExecute_CacheRevalidates304(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	cacheServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == "v1" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", "v1")
+		_, _ = w.Write([]byte(`{"color":"red"}`))
+	}))
+	defer cacheServer.Close()
+
+	// TTL of 0 means every request revalidates rather than serving a fresh hit directly.
+	client := requester.NewClient("test", requester.WithCache(requester.NewLRUCache(10)))
+	require.NoError(t, client.AddAPI("cache", discoverer.NewDirect(cacheServer.URL)))
+
+	for i := 0; i < 2; i++ {
+		req, err := client.NewRequest(context.TODO(), "cache", http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		var data struct {
+			Color string `json:"color"`
+		}
+		ok, err := client.Execute(req, &data, nil)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "red", data.Color)
+	}
+
+	assert.Equal(t, 2, calls)
+}
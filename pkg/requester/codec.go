@@ -0,0 +1,345 @@
+package requester
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// ApplicationXML is the application/xml Content-Type.
+var ApplicationXML ContentType = "application/xml"
+
+// ApplicationProtobuf is the application/x-protobuf Content-Type.
+var ApplicationProtobuf ContentType = "application/x-protobuf"
+
+// ApplicationMsgpack is the application/x-msgpack Content-Type.
+var ApplicationMsgpack ContentType = "application/x-msgpack"
+
+// ApplicationFormURLEncoded is the application/x-www-form-urlencoded Content-Type.
+var ApplicationFormURLEncoded ContentType = "application/x-www-form-urlencoded"
+
+// ApplicationOctetStream is the application/octet-stream Content-Type, used for raw byte streams.
+var ApplicationOctetStream ContentType = "application/octet-stream"
+
+// Consumer defines an interface for decoding an *http.Response body into v. Register one against a Content-Type
+// with Client.RegisterConsumer to teach a Client how to handle that Content-Type.
+type Consumer interface {
+	Decode(r *http.Response, v interface{}) error
+}
+
+// Producer defines an interface for encoding v into w, returning the Content-Type the encoded body should be sent
+// with. Register one against a Content-Type with Client.RegisterProducer to teach a Client how to produce that
+// Content-Type.
+type Producer interface {
+	Encode(v interface{}, w io.Writer) (contentType string, err error)
+}
+
+// ConsumerFunc adapts a function to a Consumer.
+type ConsumerFunc func(r *http.Response, v interface{}) error
+
+// Decode implements the Consumer.Decode method.
+func (f ConsumerFunc) Decode(r *http.Response, v interface{}) error { return f(r, v) }
+
+// ProducerFunc adapts a function to a Producer.
+type ProducerFunc func(v interface{}, w io.Writer) (string, error)
+
+// Encode implements the Producer.Encode method.
+func (f ProducerFunc) Encode(v interface{}, w io.Writer) (string, error) { return f(v, w) }
+
+// jsonConsumer implements Consumer for application/json.
+type jsonConsumer struct{}
+
+func (jsonConsumer) Decode(r *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding json: %w", err)
+	}
+	return nil
+}
+
+// jsonProducer implements Producer for application/json.
+type jsonProducer struct{}
+
+func (jsonProducer) Encode(v interface{}, w io.Writer) (string, error) {
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return "", fmt.Errorf("encoding json: %w", err)
+	}
+	return ApplicationJSON.String(), nil
+}
+
+// xmlConsumer implements Consumer for application/xml.
+type xmlConsumer struct{}
+
+func (xmlConsumer) Decode(r *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	if err := xml.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding xml: %w", err)
+	}
+	return nil
+}
+
+// xmlProducer implements Producer for application/xml.
+type xmlProducer struct{}
+
+func (xmlProducer) Encode(v interface{}, w io.Writer) (string, error) {
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		return "", fmt.Errorf("encoding xml: %w", err)
+	}
+	return ApplicationXML.String(), nil
+}
+
+// msgpackConsumer implements Consumer for application/x-msgpack.
+type msgpackConsumer struct{}
+
+func (msgpackConsumer) Decode(r *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	if err := msgpack.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding msgpack: %w", err)
+	}
+	return nil
+}
+
+// msgpackProducer implements Producer for application/x-msgpack.
+type msgpackProducer struct{}
+
+func (msgpackProducer) Encode(v interface{}, w io.Writer) (string, error) {
+	if err := msgpack.NewEncoder(w).Encode(v); err != nil {
+		return "", fmt.Errorf("encoding msgpack: %w", err)
+	}
+	return ApplicationMsgpack.String(), nil
+}
+
+// protobufConsumer implements Consumer for application/x-protobuf. v must implement proto.Message.
+type protobufConsumer struct{}
+
+func (protobufConsumer) Decode(r *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	pm, ok := v.(proto.Message)
+	if !ok {
+		return errors.New("protobuf consumer requires v to implement proto.Message")
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("reading protobuf body: %w", err)
+	}
+	if err := proto.Unmarshal(b, pm); err != nil {
+		return fmt.Errorf("decoding protobuf: %w", err)
+	}
+	return nil
+}
+
+// protobufProducer implements Producer for application/x-protobuf. v must implement proto.Message.
+type protobufProducer struct{}
+
+func (protobufProducer) Encode(v interface{}, w io.Writer) (string, error) {
+	pm, ok := v.(proto.Message)
+	if !ok {
+		return "", errors.New("protobuf producer requires v to implement proto.Message")
+	}
+	b, err := proto.Marshal(pm)
+	if err != nil {
+		return "", fmt.Errorf("encoding protobuf: %w", err)
+	}
+	if _, err := w.Write(b); err != nil {
+		return "", fmt.Errorf("writing protobuf body: %w", err)
+	}
+	return ApplicationProtobuf.String(), nil
+}
+
+// formProducer implements Producer for application/x-www-form-urlencoded. v must be a url.Values; a plain
+// map[string][]string is rejected.
+type formProducer struct{}
+
+func (formProducer) Encode(v interface{}, w io.Writer) (string, error) {
+	values, ok := v.(url.Values)
+	if !ok {
+		return "", errors.New("form producer requires v to be a url.Values")
+	}
+	if _, err := io.WriteString(w, values.Encode()); err != nil {
+		return "", fmt.Errorf("writing form body: %w", err)
+	}
+	return ApplicationFormURLEncoded.String(), nil
+}
+
+// formConsumer implements Consumer for application/x-www-form-urlencoded. v must be a *url.Values.
+type formConsumer struct{}
+
+func (formConsumer) Decode(r *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	dest, ok := v.(*url.Values)
+	if !ok {
+		return errors.New("form consumer requires v to be a *url.Values")
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("reading form body: %w", err)
+	}
+	values, err := url.ParseQuery(string(b))
+	if err != nil {
+		return fmt.Errorf("decoding form body: %w", err)
+	}
+	*dest = values
+	return nil
+}
+
+// ByteStreamConsumer implements Consumer for raw byte streams, mirroring go-openapi's ByteStreamConsumer. v must
+// be an io.Writer, to which the response body is copied.
+type ByteStreamConsumer struct {
+	closeStream bool
+}
+
+// ByteStreamOption configures a ByteStreamConsumer or ByteStreamProducer.
+type ByteStreamOption func(*byteStreamOptions)
+
+type byteStreamOptions struct {
+	closeStream bool
+}
+
+// WithByteStreamClose configures the byte-stream codec to close the underlying io.Reader/io.Writer stream (if it
+// implements io.Closer) once the copy completes.
+func WithByteStreamClose() ByteStreamOption {
+	return func(o *byteStreamOptions) {
+		o.closeStream = true
+	}
+}
+
+// NewByteStreamConsumer initializes a new ByteStreamConsumer applying any given ByteStreamOption methods.
+func NewByteStreamConsumer(options ...ByteStreamOption) *ByteStreamConsumer {
+	var o byteStreamOptions
+	for _, option := range options {
+		option(&o)
+	}
+	return &ByteStreamConsumer{closeStream: o.closeStream}
+}
+
+// Decode implements the Consumer.Decode method copying the response body into the io.Writer v.
+func (c *ByteStreamConsumer) Decode(r *http.Response, v interface{}) error {
+	w, ok := v.(io.Writer)
+	if !ok {
+		return errors.New("byte stream consumer requires v to be an io.Writer")
+	}
+	if _, err := io.Copy(w, r.Body); err != nil {
+		return fmt.Errorf("copying byte stream: %w", err)
+	}
+	if c.closeStream {
+		if closer, ok := w.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return fmt.Errorf("closing byte stream: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// ByteStreamProducer implements Producer for raw byte streams, mirroring go-openapi's ByteStreamProducer. v must
+// be an io.Reader, which is copied into the request body.
+type ByteStreamProducer struct {
+	closeStream bool
+}
+
+// NewByteStreamProducer initializes a new ByteStreamProducer applying any given ByteStreamOption methods.
+func NewByteStreamProducer(options ...ByteStreamOption) *ByteStreamProducer {
+	var o byteStreamOptions
+	for _, option := range options {
+		option(&o)
+	}
+	return &ByteStreamProducer{closeStream: o.closeStream}
+}
+
+// Encode implements the Producer.Encode method copying the io.Reader v into w.
+func (p *ByteStreamProducer) Encode(v interface{}, w io.Writer) (string, error) {
+	r, ok := v.(io.Reader)
+	if !ok {
+		return "", errors.New("byte stream producer requires v to be an io.Reader")
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return "", fmt.Errorf("copying byte stream: %w", err)
+	}
+	if p.closeStream {
+		if closer, ok := r.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return "", fmt.Errorf("closing byte stream: %w", err)
+			}
+		}
+	}
+	return ApplicationOctetStream.String(), nil
+}
+
+// csvConsumer implements Consumer for text/csv, preserving the historical decodeFile behavior: v must be an
+// io.Writer.
+type csvConsumer struct{}
+
+func (csvConsumer) Decode(r *http.Response, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	w, ok := v.(io.Writer)
+	if !ok {
+		return errors.New("successData must be an io.Writer for file decoding")
+	}
+	if _, err := io.Copy(w, r.Body); err != nil {
+		return fmt.Errorf("copying resp.Body to successData: %w", err)
+	}
+	return nil
+}
+
+// defaultConsumers returns the built-in Content-Type -> Consumer registry installed on every new Client.
+func defaultConsumers() map[string]Consumer {
+	return map[string]Consumer{
+		ApplicationJSON.String():           jsonConsumer{},
+		ApplicationXML.String():            xmlConsumer{},
+		"text/xml":                         xmlConsumer{},
+		ApplicationMsgpack.String():        msgpackConsumer{},
+		"application/msgpack":              msgpackConsumer{},
+		ApplicationProtobuf.String():       protobufConsumer{},
+		ApplicationFormURLEncoded.String(): formConsumer{},
+		TextCSV.String():                   csvConsumer{},
+		ApplicationOctetStream.String():    NewByteStreamConsumer(),
+	}
+}
+
+// defaultProducers returns the built-in Content-Type -> Producer registry installed on every new Client.
+func defaultProducers() map[string]Producer {
+	return map[string]Producer{
+		ApplicationJSON.String():           jsonProducer{},
+		ApplicationXML.String():            xmlProducer{},
+		ApplicationMsgpack.String():        msgpackProducer{},
+		ApplicationProtobuf.String():       protobufProducer{},
+		ApplicationFormURLEncoded.String(): formProducer{},
+		ApplicationOctetStream.String():    NewByteStreamProducer(),
+	}
+}
+
+// RegisterConsumer registers (or replaces) the Consumer used to decode responses with the given Content-Type.
+func (c *Client) RegisterConsumer(contentType string, consumer Consumer) {
+	c.consumers[baseContentType(contentType)] = consumer
+}
+
+// RegisterProducer registers (or replaces) the Producer used to encode requests with the given Content-Type.
+func (c *Client) RegisterProducer(contentType string, producer Producer) {
+	c.producers[baseContentType(contentType)] = producer
+}
+
+// baseContentType strips any parameters (e.g. "; charset=utf-8") from a Content-Type header value.
+func baseContentType(contentType string) string {
+	ct, _, _ := strings.Cut(contentType, ";")
+	return strings.TrimSpace(ct)
+}
@@ -0,0 +1,89 @@
+package requester_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/discoverer"
+	"github.com/syndio/apir/pkg/requester"
+)
+
+func TestClientExecute_ContentNegotiation(t *testing.T) {
+	t.Parallel()
+	// the "json" endpoint responds with Content-Type: application/json regardless of the API's configured
+	// default, so registering the API with TextCSV should not matter once Execute dispatches on the response.
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("json-as-csv-api", discoverer.NewDirect(ts.URL),
+		requester.WithContentType(requester.TextCSV)))
+
+	req, err := client.NewRequest(context.TODO(), "json-as-csv-api", http.MethodGet, "/?test=json", nil,
+		requester.WithAccept(requester.ApplicationJSON, requester.TextCSV))
+	require.NoError(t, err)
+
+	var data struct {
+		Color string `json:"color"`
+	}
+	ok, err := client.Execute(req, &data, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "red", data.Color)
+}
+
+func TestClientExecute_XML(t *testing.T) {
+	t.Parallel()
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("xml", discoverer.NewDirect(ts.URL),
+		requester.WithContentType(requester.ApplicationXML)))
+
+	req, err := client.NewRequest(context.TODO(), "xml", http.MethodGet, "/?test=xml", nil)
+	require.NoError(t, err)
+
+	var data struct {
+		Color string `xml:"color"`
+	}
+	ok, err := client.Execute(req, &data, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "red", data.Color)
+}
+
+func TestClientExecute_Form(t *testing.T) {
+	t.Parallel()
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("form", discoverer.NewDirect(ts.URL),
+		requester.WithContentType(requester.ApplicationFormURLEncoded)))
+
+	req, err := client.NewRequest(context.TODO(), "form", http.MethodGet, "/?test=form", nil)
+	require.NoError(t, err)
+
+	var data url.Values
+	ok, err := client.Execute(req, &data, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "red", data.Get("color"))
+}
+
+func TestClientRegisterConsumer(t *testing.T) {
+	t.Parallel()
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("xml", discoverer.NewDirect(ts.URL),
+		requester.WithContentType(requester.ApplicationXML)))
+
+	var called bool
+	client.RegisterConsumer("application/xml", requester.ConsumerFunc(
+		func(r *http.Response, v interface{}) error {
+			called = true
+			return nil
+		}))
+
+	req, err := client.NewRequest(context.TODO(), "xml", http.MethodGet, "/?test=xml", nil)
+	require.NoError(t, err)
+
+	_, err = client.Execute(req, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
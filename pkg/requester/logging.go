@@ -0,0 +1,166 @@
+package requester
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// maxLoggedBodyBytes caps how much of a request/response body is kept in a logged RequestLog/ResponseLog, so large
+// payloads (file downloads, bulk uploads, ...) are not written to logs or passed to hooks in full.
+const maxLoggedBodyBytes = 4096
+
+// Logger defines an interface for emitting log events from a Client. It is intentionally minimal so that most
+// structured logging libraries (e.g. zap's SugaredLogger, logrus) can satisfy it directly or with a thin adapter.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// RequestLog captures the details of an outgoing request for logging purposes.
+type RequestLog struct {
+	APIName string
+	Method  string
+	URL     string
+	Headers http.Header
+	Body    []byte
+}
+
+// ResponseLog captures the details of a received response for logging purposes.
+type ResponseLog struct {
+	APIName    string
+	Method     string
+	URL        string
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+	Duration   time.Duration
+	Retries    int
+	Err        error
+}
+
+// HeaderRedactor defines a function that returns a redacted copy of the given http.Header, suitable for logging.
+type HeaderRedactor func(http.Header) http.Header
+
+// BodyRedactor defines a function that returns a redacted copy of the given body bytes, suitable for logging.
+type BodyRedactor func([]byte) []byte
+
+// RequestHook defines a callback invoked with a RequestLog (after any redactors are applied) once the request has
+// been logged, e.g. to emit metrics or tracing spans without wrapping http.RoundTripper.
+type RequestHook func(RequestLog)
+
+// ResponseHook defines a callback invoked with a ResponseLog after a response is logged, e.g. to emit metrics or
+// tracing spans without wrapping http.RoundTripper.
+type ResponseHook func(ResponseLog)
+
+// noopLogger implements Logger discarding all log events. It is the default Logger for a Client.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
+
+// WithLogger sets the Logger used to emit request/response log events. Replaces any existing Logger.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithDebug enables verbose request/response logging (headers and bodies, subject to any configured redactors) at
+// Logger.Debug level in addition to the default Info-level summary logging.
+func WithDebug() ClientOption {
+	return func(c *Client) {
+		c.debug = true
+	}
+}
+
+// WithHeaderRedactor sets a HeaderRedactor applied to headers before they are logged. Use this to strip
+// Authorization, cookies, or other sensitive headers.
+func WithHeaderRedactor(r HeaderRedactor) ClientOption {
+	return func(c *Client) {
+		c.headerRedactor = r
+	}
+}
+
+// WithBodyRedactor sets a BodyRedactor applied to request/response bodies before they are logged. Use this to
+// strip PII or other sensitive payload data.
+func WithBodyRedactor(r BodyRedactor) ClientOption {
+	return func(c *Client) {
+		c.bodyRedactor = r
+	}
+}
+
+// WithRequestHook sets a RequestHook invoked, in addition to logging, once for every Execute that sends a request.
+// It is not invoked when Execute serves a fresh entry from the Cache.
+func WithRequestHook(h RequestHook) ClientOption {
+	return func(c *Client) {
+		c.requestHook = h
+	}
+}
+
+// WithResponseHook sets a ResponseHook invoked, in addition to logging, with the final response of every Execute
+// that sends a request. It is not invoked when Execute serves a fresh entry from the Cache.
+func WithResponseHook(h ResponseHook) ClientOption {
+	return func(c *Client) {
+		c.responseHook = h
+	}
+}
+
+func (c *Client) redactedHeaders(h http.Header) http.Header {
+	if c.headerRedactor == nil {
+		return h
+	}
+	return c.headerRedactor(h)
+}
+
+func (c *Client) redactedBody(b []byte) []byte {
+	if c.bodyRedactor == nil {
+		return b
+	}
+	return c.bodyRedactor(b)
+}
+
+func (c *Client) logRequest(rl RequestLog) {
+	rl.Headers = c.redactedHeaders(rl.Headers)
+	rl.Body = c.redactedBody(truncate(rl.Body))
+
+	c.logger.Info("executing request", "api", rl.APIName, "method", rl.Method, "url", rl.URL)
+	if c.debug {
+		c.logger.Debug("request details", "api", rl.APIName, "headers", rl.Headers, "body", string(rl.Body))
+	}
+
+	if c.requestHook != nil {
+		c.requestHook(rl)
+	}
+}
+
+func (c *Client) logResponse(rl ResponseLog) {
+	rl.Headers = c.redactedHeaders(rl.Headers)
+	rl.Body = c.redactedBody(truncate(rl.Body))
+
+	if rl.Err != nil {
+		c.logger.Error("request failed", "api", rl.APIName, "method", rl.Method, "url", rl.URL,
+			"duration", rl.Duration, "retries", rl.Retries, "error", rl.Err)
+	} else {
+		c.logger.Info("received response", "api", rl.APIName, "method", rl.Method, "url", rl.URL,
+			"status", rl.StatusCode, "duration", rl.Duration, "retries", rl.Retries)
+	}
+	if c.debug {
+		c.logger.Debug("response details", "api", rl.APIName, "headers", rl.Headers, "body", string(rl.Body))
+	}
+
+	if c.responseHook != nil {
+		c.responseHook(rl)
+	}
+}
+
+func truncate(b []byte) []byte {
+	if len(b) <= maxLoggedBodyBytes {
+		return b
+	}
+	return []byte(fmt.Sprintf("%s... (truncated, %d bytes total)", b[:maxLoggedBodyBytes], len(b)))
+}
@@ -0,0 +1,88 @@
+package requester_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/discoverer"
+	"github.com/syndio/apir/pkg/requester"
+)
+
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) Debug(msg string, _ ...interface{}) { l.record(msg) }
+func (l *recordingLogger) Info(msg string, _ ...interface{})  { l.record(msg) }
+func (l *recordingLogger) Warn(msg string, _ ...interface{})  { l.record(msg) }
+func (l *recordingLogger) Error(msg string, _ ...interface{}) { l.record(msg) }
+
+func (l *recordingLogger) record(msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, msg)
+}
+
+func (l *recordingLogger) has(msg string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, m := range l.messages {
+		if m == msg {
+			return true
+		}
+	}
+	return false
+}
+
+func TestClientExecute_Logging(t *testing.T) {
+	t.Parallel()
+	logger := &recordingLogger{}
+	client := requester.NewClient("test", requester.WithLogger(logger), requester.WithDebug())
+	require.NoError(t, client.AddAPI("json", discoverer.NewDirect(ts.URL)))
+
+	req, err := client.NewRequest(context.TODO(), "json", http.MethodGet, "/?test=json", nil)
+	require.NoError(t, err)
+
+	var data struct {
+		Color string `json:"color"`
+	}
+	ok, err := client.Execute(req, &data, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.True(t, logger.has("executing request"))
+	assert.True(t, logger.has("received response"))
+	assert.True(t, logger.has("request details"))
+	assert.True(t, logger.has("response details"))
+}
+
+func TestClientExecute_HeaderRedactor(t *testing.T) {
+	t.Parallel()
+	redacted := make(chan http.Header, 1)
+	client := requester.NewClient("test",
+		requester.WithHeaderRedactor(func(h http.Header) http.Header {
+			h = h.Clone()
+			h.Set("Authorization", "REDACTED")
+			return h
+		}),
+		requester.WithRequestHook(func(rl requester.RequestLog) {
+			redacted <- rl.Headers
+		}),
+	)
+	require.NoError(t, client.AddAPI("json", discoverer.NewDirect(ts.URL)))
+
+	req, err := client.NewRequest(context.TODO(), "json", http.MethodGet, "/?test=json", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+
+	_, err = client.Execute(req, nil, nil)
+	require.NoError(t, err)
+
+	h := <-redacted
+	assert.Equal(t, "REDACTED", h.Get("Authorization"))
+}
@@ -0,0 +1,238 @@
+package requester
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RateLimiter defines an interface for throttling requests against an API. Wait is called before a request is
+// made and should block until a token is available (or ctx is done). Update is called after a response is
+// received so the RateLimiter can adjust its state from rate-limit response headers.
+type RateLimiter interface {
+	Wait(ctx context.Context, apiName, path string) error
+	Update(apiName, path string, resp *http.Response)
+	Stats() map[string]BucketStats
+}
+
+// BucketStats reports the observable state of a single rate-limit bucket, keyed by API name and collapsed path.
+type BucketStats struct {
+	Remaining int
+	Limit     int
+	ResetAt   time.Time
+}
+
+// WithRateLimiter sets the RateLimiter used to throttle requests. Replaces any existing RateLimiter. When
+// combined with WithRetry, 429 responses are additionally honored by the retryablehttp CheckRetry/Backoff hooks so
+// retries wait out the server-mandated interval instead of exponential backoff.
+func WithRateLimiter(l RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = l
+	}
+}
+
+// Stats returns the current state of every rate-limit bucket known to the Client's RateLimiter, or nil if no
+// RateLimiter is configured.
+func (c *Client) Stats() map[string]BucketStats {
+	if c.rateLimiter == nil {
+		return nil
+	}
+	return c.rateLimiter.Stats()
+}
+
+// idSegment matches path segments that look like resource identifiers (numeric or UUID), so that e.g.
+// "/users/1234" and "/users/5678" collapse to the same bucket key "/users/{id}".
+var idSegment = regexp.MustCompile(`^(\d+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$`)
+
+// collapsePath replaces identifier-looking path segments with "{id}" so that per-resource endpoints share a
+// single rate-limit bucket.
+func collapsePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if idSegment.MatchString(s) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// bucketKey returns the RateLimiter bucket key for the given API name and path.
+func bucketKey(apiName, path string) string {
+	return apiName + " " + collapsePath(path)
+}
+
+// bucket tracks token-bucket state for a single API+path pattern.
+type bucket struct {
+	mu        sync.Mutex
+	tokens    int
+	limit     int
+	resetAt   time.Time
+	blockedAt time.Time
+}
+
+// TokenBucket implements RateLimiter as an in-memory token bucket per API name + pattern-collapsed path. It
+// acquires a token before a request is made and replenishes/adjusts its limit from the `X-RateLimit-Remaining`,
+// `X-RateLimit-Reset`, and `Retry-After` response headers. On a 429 response the bucket blocks until the reset
+// timestamp, regardless of remaining tokens.
+type TokenBucket struct {
+	// DefaultLimit seeds a bucket's token count the first time it is seen, before any response headers have been
+	// observed. Defaults to a generous 100 if unset.
+	DefaultLimit int
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewTokenBucket initializes a new TokenBucket RateLimiter.
+func NewTokenBucket() *TokenBucket {
+	return &TokenBucket{DefaultLimit: 100, buckets: make(map[string]*bucket)}
+}
+
+func (t *TokenBucket) bucketFor(key string) *bucket {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, ok := t.buckets[key]
+	if !ok {
+		limit := t.DefaultLimit
+		if limit <= 0 {
+			limit = 100
+		}
+		b = &bucket{tokens: limit, limit: limit}
+		t.buckets[key] = b
+	}
+	return b
+}
+
+// Wait implements the RateLimiter.Wait method, blocking until a token is available or a 429-triggered block
+// expires.
+func (t *TokenBucket) Wait(ctx context.Context, apiName, path string) error {
+	b := t.bucketFor(bucketKey(apiName, path))
+
+	for {
+		b.mu.Lock()
+		now := time.Now()
+
+		if !b.blockedAt.IsZero() && now.Before(b.resetAt) {
+			wait := b.resetAt.Sub(now)
+			b.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("context done waiting for rate limit reset: %w", ctx.Err())
+			case <-time.After(wait):
+				continue
+			}
+		}
+		b.blockedAt = time.Time{}
+
+		if !b.resetAt.IsZero() && now.After(b.resetAt) {
+			b.tokens = b.limit
+		}
+
+		if b.tokens > 0 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Second
+		if !b.resetAt.IsZero() {
+			wait = b.resetAt.Sub(now)
+		}
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context done waiting for rate limit token: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Update implements the RateLimiter.Update method, adjusting bucket state from response headers.
+func (t *TokenBucket) Update(apiName, path string, resp *http.Response) {
+	b := t.bucketFor(bucketKey(apiName, path))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit")); err == nil {
+		b.limit = limit
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		b.tokens = remaining
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		b.resetAt = time.Unix(reset, 0)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		b.blockedAt = time.Now()
+		if retryAfter := retryAfterDuration(resp.Header.Get("Retry-After")); retryAfter > 0 {
+			b.resetAt = time.Now().Add(retryAfter)
+		}
+		b.tokens = 0
+	}
+}
+
+// Stats implements the RateLimiter.Stats method.
+func (t *TokenBucket) Stats() map[string]BucketStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := make(map[string]BucketStats, len(t.buckets))
+	for key, b := range t.buckets {
+		b.mu.Lock()
+		stats[key] = BucketStats{Remaining: b.tokens, Limit: b.limit, ResetAt: b.resetAt}
+		b.mu.Unlock()
+	}
+	return stats
+}
+
+// retryAfterDuration parses a Retry-After header value, which may be either a number of seconds or an HTTP-date.
+func retryAfterDuration(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// RateLimitedRetryPolicy builds go-retryablehttp CheckRetry and Backoff functions that honor a 429 response's
+// Retry-After header rather than the default exponential backoff. WithRetry installs this by default; callers
+// building their own retryablehttp.Client (e.g. to further customize it before passing it to WithClient) can wire
+// it up the same way:
+//
+//	rc := retryablehttp.NewClient()
+//	rc.CheckRetry, rc.Backoff = requester.RateLimitedRetryPolicy()
+func RateLimitedRetryPolicy() (retryablehttp.CheckRetry, retryablehttp.Backoff) {
+	checkRetry := func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			return true, nil
+		}
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	backoff := func(minWait, maxWait time.Duration, attempt int, resp *http.Response) time.Duration {
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			if wait := retryAfterDuration(resp.Header.Get("Retry-After")); wait > 0 {
+				return wait
+			}
+		}
+		return retryablehttp.DefaultBackoff(minWait, maxWait, attempt, resp)
+	}
+
+	return checkRetry, backoff
+}
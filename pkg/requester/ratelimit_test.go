@@ -0,0 +1,41 @@
+package requester_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/requester"
+)
+
+func TestTokenBucketWaitConsumesTokens(t *testing.T) {
+	t.Parallel()
+	tb := requester.NewTokenBucket()
+	tb.DefaultLimit = 2
+
+	require.NoError(t, tb.Wait(context.Background(), "api", "/users/1"))
+	require.NoError(t, tb.Wait(context.Background(), "api", "/users/2"))
+
+	stats := tb.Stats()
+	s, ok := stats["api /users/{id}"]
+	require.True(t, ok, "expected a bucket keyed by the collapsed path")
+	assert.Equal(t, 0, s.Remaining)
+}
+
+func TestTokenBucketUpdateHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+	tb := requester.NewTokenBucket()
+
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Retry-After", "1")
+	rec.WriteHeader(http.StatusTooManyRequests)
+	tb.Update("api", "/users", rec.Result())
+
+	start := time.Now()
+	require.NoError(t, tb.Wait(context.Background(), "api", "/users"))
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
@@ -2,13 +2,14 @@
 package requester
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -45,7 +46,10 @@ type Requester interface {
 // API defines an API and is embedded in a Client via MustAddAPI.
 type API struct {
 	Discoverer
-	contentType ContentType
+	name          string
+	contentType   ContentType
+	authenticator Authenticator
+	cacheTTL      time.Duration
 }
 
 // APIOption defines configuration options for an API.
@@ -66,23 +70,56 @@ type Client struct {
 	name   string
 	client *http.Client
 	apis   map[string]*API
+
+	logger         Logger
+	debug          bool
+	headerRedactor HeaderRedactor
+	bodyRedactor   BodyRedactor
+	requestHook    RequestHook
+	responseHook   ResponseHook
+
+	consumers map[string]Consumer
+	producers map[string]Producer
+
+	rateLimiter RateLimiter
+
+	cache Cache
+
+	// countingInstalled records whether a ClientOption (WithClient, WithRetry) already installed a
+	// countingTransport at the layer that performs each actual HTTP attempt, so NewClient's default install
+	// doesn't wrap it a second time and inflate the attempt count.
+	countingInstalled bool
 }
 
 // ClientOption defines configuration options for a Client.
 type ClientOption func(*Client)
 
-// WithClient sets the underlying *http.Client for a Client. Replaces any existing *http.Client.
+// WithClient sets the underlying *http.Client for a Client. Replaces any existing *http.Client. The client's
+// Transport is wrapped to count attempts (see countingTransport) the same way NewClient's default is.
 func WithClient(hc *http.Client) ClientOption {
 	return func(c *Client) {
+		hc.Transport = &countingTransport{next: hc.Transport}
 		c.client = hc
+		c.countingInstalled = true
 	}
 }
 
-// WithRetry sets the underlying *http.Client with one configured for automated retry. Replaces any existing *http.Client.
+// WithRetry sets the underlying *http.Client with one configured for automated retry. Replaces any existing
+// *http.Client. Retries honor a 429 response's Retry-After header (via RateLimitedRetryPolicy) rather than
+// falling back to exponential backoff, so this composes with WithRateLimiter without the caller wiring up their
+// own retryablehttp.Client.
+//
+// retryablehttp.Client.StandardClient() performs every retry internally against its own rc.HTTPClient, so a single
+// call through the returned *http.Client only ever invokes that client's outer Transport once regardless of how
+// many underlying attempts it made. The attempt counter (see countingTransport) is therefore installed on
+// rc.HTTPClient's Transport, not on the StandardClient's, so it increments once per actual HTTP attempt.
 func WithRetry() ClientOption {
 	return func(c *Client) {
 		rc := retryablehttp.NewClient()
+		rc.CheckRetry, rc.Backoff = RateLimitedRetryPolicy()
+		rc.HTTPClient.Transport = &countingTransport{next: rc.HTTPClient.Transport}
 		c.client = rc.StandardClient()
+		c.countingInstalled = true
 	}
 }
 
@@ -96,13 +133,24 @@ func WithTimeout(t time.Duration) ClientOption {
 // NewClient creates a new Client with sane defaults and applies any given ClientOption methods.
 func NewClient(name string, options ...ClientOption) *Client {
 	c := &Client{
-		name:   name,
-		client: http.DefaultClient,
-		apis:   make(map[string]*API),
+		name:      name,
+		client:    &http.Client{},
+		apis:      make(map[string]*API),
+		logger:    noopLogger{},
+		consumers: defaultConsumers(),
+		producers: defaultProducers(),
 	}
 	for _, option := range options {
 		option(c)
 	}
+
+	// WithClient and WithRetry each install their own countingTransport on the layer that actually performs each
+	// HTTP attempt (see WithRetry's doc comment); only wrap the default client here, so a retry-aware *http.Client
+	// doesn't get its outer, once-per-call Transport double-counted on top of its inner, once-per-attempt one.
+	if !c.countingInstalled {
+		c.client.Transport = &countingTransport{next: c.client.Transport}
+	}
+
 	return c
 }
 
@@ -112,7 +160,7 @@ func (c *Client) MustAddAPI(name string, discoverer Discoverer, options ...APIOp
 		panic(fmt.Sprintf("api %q already initialized", name))
 	}
 
-	api := &API{Discoverer: discoverer}
+	api := &API{Discoverer: discoverer, name: name}
 	for _, option := range options {
 		option(api)
 	}
@@ -127,8 +175,9 @@ func (c *Client) MustAddAPI(name string, discoverer Discoverer, options ...APIOp
 
 // Request defines a http request to be made to an API.
 type Request struct {
-	api       *API
-	userAgent string
+	api           *API
+	userAgent     string
+	authenticator Authenticator
 	*http.Request
 }
 
@@ -142,6 +191,19 @@ func WithUserAgent(ua string) RequestOption {
 	}
 }
 
+// WithAccept sets the Accept header to the given Content-Types, in order of preference, allowing a single API to
+// return different Content-Types for different endpoints (e.g. CSV for one, JSON for another). Execute dispatches
+// decoding based on the response's actual Content-Type, not this preference list.
+func WithAccept(contentTypes ...ContentType) RequestOption {
+	return func(r *Request) {
+		strs := make([]string, len(contentTypes))
+		for i, ct := range contentTypes {
+			strs[i] = ct.String()
+		}
+		r.Header.Set("Accept", strings.Join(strs, ", "))
+	}
+}
+
 // NewRequest creates a new Request for the given inputs applying any given RequestOption methods.
 func (c *Client) NewRequest(ctx context.Context, apiName, method, url string, body io.Reader, options ...RequestOption) (*Request, error) {
 	api, ok := c.apis[apiName]
@@ -171,64 +233,253 @@ func (c *Client) NewRequest(ctx context.Context, apiName, method, url string, bo
 	return r, nil
 }
 
-// Execute makes the given Request optionally decoding the response into given successData and/or errorData. The bool value returned indicates if the request was made successfully or not regardless of the response.
+// Execute makes the given Request optionally decoding the response into given successData and/or errorData. The
+// bool return value is true for any response status code below 400, even if decoding successData subsequently
+// fails (that failure is reported via the error return instead); it is false for a status code of 400 or above,
+// or if the response's Content-Type has no registered Consumer at all.
 func (c *Client) Execute(req *Request, successData, errorData interface{}) (bool, error) {
-	resp, err := c.client.Do(req.Request)
-	if err != nil {
-		return false, fmt.Errorf("error making request: %w", err)
-	}
-	defer func() {
-		err = resp.Body.Close()
+	ok, _, _, err := c.executeWithHeader(req, successData, errorData)
+	return ok, err
+}
+
+// executeWithHeader is the implementation behind Execute, additionally returning the response status code and
+// Header (or, on a fresh/revalidated cache hit, the cached entry's) so in-package callers such as Service and
+// Paginator can inspect the response (e.g. to follow a Link header, or report a status code in their own error
+// types) without Execute's public signature growing more return values.
+func (c *Client) executeWithHeader(req *Request, successData, errorData interface{}) (bool, int, http.Header, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
 		if err != nil {
-			fmt.Printf("error closing response body: %+v", err)
+			return false, 0, nil, fmt.Errorf("error reading request body for logging: %w", err)
 		}
-	}()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
 
-	var ok bool
-	switch req.api.contentType {
-	case ApplicationJSON:
-		ok, err = decodeJSON(resp, successData, errorData)
-	case TextCSV:
-		// TODO: decodeFile does not currently support errorData
-		ok, err = decodeFile(resp, successData)
-	default:
-		return false, fmt.Errorf("content type %q not implemented", req.api.contentType)
+	auth := req.authenticator
+	if auth == nil {
+		auth = req.api.authenticator
+	}
+	if auth != nil {
+		if err := auth.Apply(req.Request); err != nil {
+			return false, 0, nil, fmt.Errorf("error applying authenticator: %w", err)
+		}
 	}
 
-	return ok, err
-}
+	var cachedEntry *CachedResponse
+	var key string
+	if c.cache != nil && isCacheable(req.Method) {
+		key = cacheKey(req.Method, req.URL.String())
+		if cr, ok := c.cache.Get(key); ok {
+			if cr.Fresh() {
+				ok, err := c.decode(cr.StatusCode, cr.Header, cr.Body, req.api.contentType.String(), successData, errorData)
+				return ok, cr.StatusCode, cr.Header, err
+			}
+			cachedEntry = cr
+			if etag := cr.Header.Get("ETag"); etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			if lm := cr.Header.Get("Last-Modified"); lm != "" {
+				req.Header.Set("If-Modified-Since", lm)
+			}
+		}
+	}
+
+	c.logRequest(RequestLog{
+		APIName: req.api.name,
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: req.Header,
+		Body:    reqBody,
+	})
+
+	resp, respBody, duration, retries, err := c.send(req)
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	if cachedEntry != nil && resp.StatusCode == http.StatusNotModified {
+		c.logResponse(ResponseLog{
+			APIName: req.api.name, Method: req.Method, URL: req.URL.String(),
+			StatusCode: resp.StatusCode, Headers: resp.Header, Body: respBody,
+			Duration: duration, Retries: retries,
+		})
+
+		cachedEntry.StoredAt = time.Now()
+		c.cache.Set(key, cachedEntry, cachedEntry.TTL)
+		ok, err := c.decode(cachedEntry.StatusCode, cachedEntry.Header, cachedEntry.Body, req.api.contentType.String(), successData, errorData)
+		return ok, cachedEntry.StatusCode, cachedEntry.Header, err
+	}
 
-func decodeJSON(resp *http.Response, successData, errorData interface{}) (bool, error) {
-	if resp.StatusCode >= http.StatusBadRequest {
-		if errorData != nil {
-			if err := json.NewDecoder(resp.Body).Decode(&errorData); err != nil {
-				return false, fmt.Errorf("decoding errorData: %w", err)
+	if c.cache != nil && isCacheable(req.Method) && resp.StatusCode < http.StatusBadRequest {
+		c.maybeStoreCacheEntry(key, resp, respBody, req.api.cacheTTL)
+	}
+
+	if auth != nil && resp.StatusCode == http.StatusUnauthorized {
+		if refresher, ok := auth.(Refresher); ok {
+			if refreshErr := refresher.Refresh(req.Context()); refreshErr == nil {
+				req.Body = io.NopCloser(bytes.NewReader(reqBody))
+				if applyErr := auth.Apply(req.Request); applyErr == nil {
+					resp, respBody, duration, retries, err = c.send(req)
+					if err != nil {
+						return false, 0, nil, err
+					}
+				}
 			}
-			return false, nil
 		}
-		// TODO: better error situation here
-		return false, fmt.Errorf("%d:%s", resp.StatusCode, resp.Body)
 	}
-	if successData != nil {
-		if err := json.NewDecoder(resp.Body).Decode(&successData); err != nil {
-			return true, fmt.Errorf("decoding successData: %w", err)
+
+	c.logResponse(ResponseLog{
+		APIName: req.api.name, Method: req.Method, URL: req.URL.String(),
+		StatusCode: resp.StatusCode, Headers: resp.Header, Body: respBody,
+		Duration: duration, Retries: retries,
+	})
+
+	ok, err := c.decode(resp.StatusCode, resp.Header, respBody, req.api.contentType.String(), successData, errorData)
+	return ok, resp.StatusCode, resp.Header, err
+}
+
+// decode dispatches to the Consumer registered for contentType, falling back to defaultContentType (the API's
+// configured content type) when the response either did not specify a Content-Type or specified one with no
+// registered Consumer (e.g. a file download served with an OS-dependent Content-Type like text/plain).
+func (c *Client) decode(statusCode int, header http.Header, body []byte, defaultContentType string, successData, errorData interface{}) (bool, error) {
+	contentType := baseContentType(header.Get("Content-Type"))
+
+	consumer, ok := c.consumers[contentType]
+	if !ok {
+		contentType = defaultContentType
+		consumer, ok = c.consumers[contentType]
+	}
+	if !ok {
+		return false, fmt.Errorf("content type %q not implemented", contentType)
+	}
+
+	resp := &http.Response{StatusCode: statusCode, Header: header, Body: io.NopCloser(bytes.NewReader(body))}
+
+	if statusCode >= http.StatusBadRequest {
+		if errorData == nil {
+			// TODO: better error situation here
+			return false, fmt.Errorf("%d:%s", statusCode, body)
+		}
+		if err := consumer.Decode(resp, errorData); err != nil {
+			return false, fmt.Errorf("decoding errorData: %w", err)
 		}
+		return false, nil
 	}
+
+	if err := consumer.Decode(resp, successData); err != nil {
+		return true, fmt.Errorf("decoding successData: %w", err)
+	}
+
 	return true, nil
 }
 
-func decodeFile(resp *http.Response, successData interface{}) (bool, error) {
-	if resp.StatusCode >= http.StatusBadRequest {
-		return false, fmt.Errorf("%d:%s", resp.StatusCode, resp.Body)
+// maybeStoreCacheEntry stores resp in the Client's Cache under key, unless the response forbids storage via
+// Cache-Control: no-store. The response's Cache-Control max-age, if present, overrides ttl.
+func (c *Client) maybeStoreCacheEntry(key string, resp *http.Response, body []byte, ttl time.Duration) {
+	cacheControl := resp.Header.Get("Cache-Control")
+	if strings.Contains(cacheControl, "no-store") {
+		return
 	}
-	if successData != nil {
-		w, ok := successData.(io.Writer)
-		if !ok {
-			return false, errors.New("successData must be an io.Writer for file decoding")
+	if maxAge, ok := parseMaxAge(cacheControl); ok {
+		ttl = maxAge
+	}
+
+	c.cache.Set(key, &CachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		StoredAt:   time.Now(),
+	}, ttl)
+}
+
+// parseMaxAge extracts the max-age directive (in seconds) from a Cache-Control header value.
+func parseMaxAge(cacheControl string) (time.Duration, bool) {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(directive), "=")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "max-age") {
+			continue
 		}
-		if _, err := io.Copy(w, resp.Body); err != nil {
-			return true, fmt.Errorf("copying resp.Body to successData: %w", err)
+		seconds, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
 		}
+		return time.Duration(seconds) * time.Second, true
 	}
-	return true, nil
+	return 0, false
+}
+
+// send performs a single attempt at making req, returning the response, its fully-read body (restored onto
+// resp.Body for callers that decode from it directly), the request duration, and the number of retries the
+// underlying *http.Client performed (driven by e.g. WithRetry).
+func (c *Client) send(req *Request) (*http.Response, []byte, time.Duration, int, error) {
+	var attempts int32
+	ctx := context.WithValue(req.Context(), attemptsContextKey, &attempts)
+	req.Request = req.Request.WithContext(ctx)
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx, req.api.name, req.URL.Path); err != nil {
+			return nil, nil, 0, 0, fmt.Errorf("error waiting for rate limit: %w", err)
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.client.Do(req.Request)
+	duration := time.Since(start)
+	retries := int(attempts) - 1
+	if retries < 0 {
+		retries = 0
+	}
+
+	if err != nil {
+		c.logResponse(ResponseLog{
+			APIName: req.api.name, Method: req.Method, URL: req.URL.String(),
+			Duration: duration, Retries: retries, Err: err,
+		})
+		return nil, nil, duration, retries, fmt.Errorf("error making request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			fmt.Printf("error closing response body: %+v", closeErr)
+		}
+	}()
+
+	if c.rateLimiter != nil {
+		c.rateLimiter.Update(req.api.name, req.URL.Path, resp)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, duration, retries, fmt.Errorf("error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	return resp, respBody, duration, retries, nil
+}
+
+// attemptsContextKeyType is an unexported type used to avoid context key collisions for attemptsContextKey.
+type attemptsContextKeyType struct{}
+
+// attemptsContextKey is the context key under which Execute stores a per-request attempt counter, incremented by
+// countingTransport on every RoundTrip so that retries (driven by e.g. WithRetry) can be reported in ResponseLog.
+var attemptsContextKey = attemptsContextKeyType{}
+
+// countingTransport wraps an http.RoundTripper incrementing the *int32 counter stored in the request context (see
+// attemptsContextKey) on every attempt, so that Execute can report how many retries a request took.
+type countingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if counter, ok := req.Context().Value(attemptsContextKey).(*int32); ok {
+		atomic.AddInt32(counter, 1)
+	}
+
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return next.RoundTrip(req)
 }
@@ -42,6 +42,20 @@ func testHandler(w http.ResponseWriter, r *http.Request) { //nolint:cyclop
 			panic(err)
 		}
 
+		return
+	case "xml":
+		w.Header().Set("Content-Type", "application/xml")
+		if _, err := w.Write([]byte(`<payload><color>red</color></payload>`)); err != nil {
+			panic(err)
+		}
+
+		return
+	case "form":
+		w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+		if _, err := w.Write([]byte(`color=red&size=small`)); err != nil {
+			panic(err)
+		}
+
 		return
 	case "timeout":
 		time.Sleep(1 * time.Second)
@@ -141,7 +155,7 @@ func TestClientExecute_CSV(t *testing.T) {
 		}
 		ok, err := client.Execute(req, &data, nil)
 		require.Error(t, err)
-		assert.False(t, ok)
+		assert.True(t, ok, "the request itself succeeded even though decoding successData failed")
 	})
 }
 
@@ -201,7 +215,10 @@ func TestClientExecute_NoErrorData(t *testing.T) {
 
 func TestClientExecute_Retry(t *testing.T) {
 	t.Parallel()
-	client := requester.NewClient(t.Name(), requester.WithRetry())
+
+	var logs []requester.ResponseLog
+	client := requester.NewClient(t.Name(), requester.WithRetry(),
+		requester.WithResponseHook(func(rl requester.ResponseLog) { logs = append(logs, rl) }))
 	require.NoError(t, client.AddAPI("retry", discoverer.NewDirect(ts.URL),
 		requester.WithContentType(requester.ApplicationJSON)))
 
@@ -219,6 +236,9 @@ func TestClientExecute_Retry(t *testing.T) {
 
 	assert.Equal(t, "red", data.Color)
 	assert.True(t, retryMap[req.URL.Path], "no retry recorded")
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, 1, logs[0].Retries, "testHandler fails the first attempt only, so exactly one retry is expected")
 }
 
 func TestClientExecute_Timeout(t *testing.T) {
@@ -0,0 +1,216 @@
+package requester
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Service is a thin, embeddable helper for building typed endpoint groups on top of a Client. Callers define their
+// own service types embedding a *Service, e.g.:
+//
+//	type UsersService struct{ *requester.Service }
+//
+//	func (s *UsersService) Get(ctx context.Context, id string) (*User, error) {
+//		var user User
+//		if err := s.Service.Get(ctx, "/users/"+id, &user); err != nil {
+//			return nil, err
+//		}
+//		return &user, nil
+//	}
+//
+// and get idiomatic, typed method calls instead of re-deriving a *Request and calling Client.Execute by hand.
+type Service struct {
+	Client   *Client
+	APIName  string
+	BasePath string
+}
+
+// NewService initializes a new Service for the given Client and API name, with paths resolved relative to
+// basePath.
+func NewService(client *Client, apiName, basePath string) *Service {
+	return &Service{Client: client, APIName: apiName, BasePath: basePath}
+}
+
+// ServiceError is the errorData decoded from a response with a status code of 400 or above by Service's Get/Post/Put/Patch/Delete helpers.
+// Services wanting a richer, API-specific error shape should call Client.Execute directly instead.
+type ServiceError struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e *ServiceError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
+// Get makes a GET request against path (resolved relative to BasePath), decoding a successful response into out.
+func (s *Service) Get(ctx context.Context, path string, out interface{}, options ...RequestOption) error {
+	return s.do(ctx, http.MethodGet, path, nil, out, options...)
+}
+
+// Post makes a POST request against path (resolved relative to BasePath), encoding body as the request payload and
+// decoding a successful response into out.
+func (s *Service) Post(ctx context.Context, path string, body, out interface{}, options ...RequestOption) error {
+	return s.do(ctx, http.MethodPost, path, body, out, options...)
+}
+
+// Put makes a PUT request against path (resolved relative to BasePath), encoding body as the request payload and
+// decoding a successful response into out.
+func (s *Service) Put(ctx context.Context, path string, body, out interface{}, options ...RequestOption) error {
+	return s.do(ctx, http.MethodPut, path, body, out, options...)
+}
+
+// Patch makes a PATCH request against path (resolved relative to BasePath), encoding body as the request payload
+// and decoding a successful response into out.
+func (s *Service) Patch(ctx context.Context, path string, body, out interface{}, options ...RequestOption) error {
+	return s.do(ctx, http.MethodPatch, path, body, out, options...)
+}
+
+// Delete makes a DELETE request against path (resolved relative to BasePath), decoding a successful response into
+// out (pass nil if the endpoint returns no body worth decoding).
+func (s *Service) Delete(ctx context.Context, path string, out interface{}, options ...RequestOption) error {
+	return s.do(ctx, http.MethodDelete, path, nil, out, options...)
+}
+
+// do builds and executes a Request for method/path (resolved relative to BasePath), folding request-body
+// encoding, error-status handling, and response decoding into a single call so typed services don't need to
+// repeat that boilerplate per endpoint.
+func (s *Service) do(ctx context.Context, method, path string, body, out interface{}, options ...RequestOption) error {
+	ok, _, _, err := s.execute(ctx, method, s.fullPath(path), body, out, options...)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("request was not successful")
+	}
+	return nil
+}
+
+// execute is the shared implementation behind do and Paginator, additionally surfacing the response status code
+// and Header so Paginator can follow a Link: rel="next" header and ServiceError can report a status code, without
+// Service's public methods growing more return values. Unlike do, execute takes an already-resolved path: do
+// resolves it relative to BasePath, while Paginator's subsequent pages resolve it directly from the prior
+// response's Link header.
+func (s *Service) execute(ctx context.Context, method, resolvedPath string, body, out interface{}, options ...RequestOption) (bool, int, http.Header, error) {
+	r, err := s.encodeBody(body)
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	req, err := s.Client.NewRequest(ctx, s.APIName, method, resolvedPath, r, options...)
+	if err != nil {
+		return false, 0, nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	var svcErr ServiceError
+	ok, statusCode, header, err := s.Client.executeWithHeader(req, out, &svcErr)
+	if err != nil {
+		return false, 0, nil, fmt.Errorf("error executing request: %w", err)
+	}
+	if !ok {
+		svcErr.StatusCode = statusCode
+		return false, statusCode, header, &svcErr
+	}
+	return true, statusCode, header, nil
+}
+
+// fullPath resolves path relative to BasePath.
+func (s *Service) fullPath(path string) string {
+	return strings.TrimRight(s.BasePath, "/") + "/" + strings.TrimLeft(path, "/")
+}
+
+// encodeBody encodes body using the Producer registered for the Service's API content type. Returns a nil
+// io.Reader when body is nil, so GET/DELETE requests with no payload pass nil through to NewRequest unchanged.
+func (s *Service) encodeBody(body interface{}) (io.Reader, error) {
+	if body == nil {
+		return nil, nil
+	}
+
+	api, ok := s.Client.apis[s.APIName]
+	if !ok {
+		return nil, fmt.Errorf("api %q not initialized", s.APIName)
+	}
+
+	producer, ok := s.Client.producers[api.contentType.String()]
+	if !ok {
+		return nil, fmt.Errorf("no producer registered for content type %q", api.contentType)
+	}
+
+	var buf bytes.Buffer
+	if _, err := producer.Encode(body, &buf); err != nil {
+		return nil, fmt.Errorf("error encoding request body: %w", err)
+	}
+	return &buf, nil
+}
+
+// Paginator walks a paginated GET endpoint, following the response's Link: rel="next" header (RFC 5988) until
+// none is present, decoding each page with newPage and handing it to fn.
+type Paginator struct {
+	Service *Service
+	Path    string
+	Options []RequestOption
+}
+
+// NewPaginator initializes a new Paginator for the given Service and starting path.
+func NewPaginator(service *Service, path string, options ...RequestOption) *Paginator {
+	return &Paginator{Service: service, Path: path, Options: options}
+}
+
+// Pages fetches each page in turn, starting at the Paginator's Path, calling newPage to allocate the decode target
+// for a page and fn with the decoded page. Pages stops and returns nil when there is no next page, stops and
+// returns fn's error if fn returns one, or stops and returns the error from fetching/decoding a page.
+func (p *Paginator) Pages(ctx context.Context, newPage func() interface{}, fn func(page interface{}) error) error {
+	// the first page is resolved relative to BasePath like any other Service call; subsequent pages come from the
+	// prior response's Link header, which already names the full path from the API root.
+	resolvedPath := p.Service.fullPath(p.Path)
+
+	for resolvedPath != "" {
+		page := newPage()
+		ok, _, header, err := p.Service.execute(ctx, http.MethodGet, resolvedPath, nil, page, p.Options...)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("request was not successful")
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		resolvedPath = nextLinkPath(header)
+	}
+	return nil
+}
+
+// nextLinkPath extracts the path (and query) of a rel="next" entry in a Link header (RFC 5988), or "" if there
+// isn't one. The Link target is expected to resolve against the same API host as the Paginator's Service.
+func nextLinkPath(header http.Header) string {
+	for _, link := range strings.Split(header.Get("Link"), ",") {
+		link = strings.TrimSpace(link)
+		if link == "" {
+			continue
+		}
+
+		rawURL, params, found := strings.Cut(link, ";")
+		if !found {
+			continue
+		}
+		if !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+
+		rawURL = strings.Trim(strings.TrimSpace(rawURL), "<>")
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return rawURL
+		}
+		return u.RequestURI()
+	}
+	return ""
+}
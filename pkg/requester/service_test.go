@@ -0,0 +1,113 @@
+package requester_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndio/apir/pkg/discoverer"
+	"github.com/syndio/apir/pkg/requester"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func TestServiceGet(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"ada"}`))
+	}))
+	defer server.Close()
+
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("users", discoverer.NewDirect(server.URL)))
+	svc := requester.NewService(client, "users", "/users")
+
+	var u user
+	require.NoError(t, svc.Get(context.TODO(), "/1", &u))
+	assert.Equal(t, "ada", u.Name)
+}
+
+func TestServicePost(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"ada"}`))
+	}))
+	defer server.Close()
+
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("users", discoverer.NewDirect(server.URL)))
+	svc := requester.NewService(client, "users", "/users")
+
+	var u user
+	require.NoError(t, svc.Post(context.TODO(), "/", &user{Name: "ada"}, &u))
+	assert.Equal(t, "ada", u.Name)
+}
+
+func TestServiceGet_ErrorStatus(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("users", discoverer.NewDirect(server.URL)))
+	svc := requester.NewService(client, "users", "/users")
+
+	var u user
+	err := svc.Get(context.TODO(), "/missing", &u)
+	require.Error(t, err)
+
+	var svcErr *requester.ServiceError
+	require.ErrorAs(t, err, &svcErr)
+	assert.Equal(t, http.StatusNotFound, svcErr.StatusCode)
+	assert.Equal(t, "not found", svcErr.Message)
+}
+
+func TestPaginatorPages(t *testing.T) {
+	t.Parallel()
+
+	var requestedPaths []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/users?page=2>; rel="next"`, server.URL))
+			_, _ = w.Write([]byte(`[{"name":"ada"}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"name":"grace"}]`))
+		}
+	}))
+	defer server.Close()
+
+	client := requester.NewClient("test")
+	require.NoError(t, client.AddAPI("users", discoverer.NewDirect(server.URL)))
+	svc := requester.NewService(client, "users", "/users")
+
+	p := requester.NewPaginator(svc, "/")
+
+	var names []string
+	err := p.Pages(context.TODO(), func() interface{} { return &[]user{} }, func(page interface{}) error {
+		for _, u := range *page.(*[]user) {
+			names = append(names, u.Name)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ada", "grace"}, names)
+	assert.Equal(t, []string{"/users/", "/users?page=2"}, requestedPaths)
+}